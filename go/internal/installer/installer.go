@@ -0,0 +1,220 @@
+// Package installer downloads and caches the libpact_ffi release artifact
+// matching the running platform, so users don't have to hand-install the
+// right .so/.dylib/.dll themselves before pact-go will load.
+package installer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const releaseBaseURL = "https://github.com/pact-foundation/pact-reference/releases/download/libpact_ffi-v%s/%s"
+
+// Ensure makes sure the libpact_ffi release asset for version is present in
+// the local cache, downloading and checksum-verifying it on first use, and
+// returns the path to the cached shared library.
+func Ensure(version string) (string, error) {
+	asset, err := assetName()
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := cacheDir(version)
+	if err != nil {
+		return "", err
+	}
+
+	libPath := filepath.Join(dir, libraryFileName())
+	if _, err := os.Stat(libPath); err == nil {
+		return libPath, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("installer: failed to create cache dir %s: %w", dir, err)
+	}
+
+	url := fmt.Sprintf(releaseBaseURL, version, asset)
+	data, err := download(url)
+	if err != nil {
+		return "", err
+	}
+
+	checksumURL := url + ".sha256"
+	if err := verifyChecksum(data, checksumURL); err != nil {
+		return "", err
+	}
+
+	library, err := gunzip(data)
+	if err != nil {
+		return "", fmt.Errorf("installer: failed to decompress %s: %w", asset, err)
+	}
+
+	if err := os.WriteFile(libPath, library, 0o755); err != nil {
+		return "", fmt.Errorf("installer: failed to write %s: %w", libPath, err)
+	}
+
+	return libPath, nil
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// cacheDir returns $XDG_CACHE_HOME/pact-go/ffi/<version>/, falling back to
+// $HOME/.cache when XDG_CACHE_HOME is unset.
+func cacheDir(version string) (string, error) {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("installer: unable to determine cache directory: %w", err)
+		}
+		base = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(base, "pact-go", "ffi", version), nil
+}
+
+func libraryFileName() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "libpact_ffi.dylib"
+	case "windows":
+		return "pact_ffi.dll"
+	default:
+		return "libpact_ffi.so"
+	}
+}
+
+// platform identifies a release artifact: OS, architecture, and (on Linux)
+// whether the host uses musl libc instead of glibc.
+type platform struct {
+	os   string
+	arch string
+	musl bool
+}
+
+func detectPlatform() (platform, error) {
+	p := platform{os: runtime.GOOS, arch: runtime.GOARCH}
+
+	switch p.os {
+	case "linux", "darwin", "windows":
+	default:
+		return p, fmt.Errorf("installer: unsupported OS %s", p.os)
+	}
+
+	switch p.arch {
+	case "amd64", "arm64":
+	default:
+		return p, fmt.Errorf("installer: unsupported architecture %s", p.arch)
+	}
+
+	if p.os == "linux" {
+		p.musl = isMusl()
+	}
+
+	return p, nil
+}
+
+// isMusl reports whether the host's C library is musl rather than glibc, by
+// checking for musl's dynamic loader first and falling back to parsing
+// `ldd --version`.
+func isMusl() bool {
+	if matches, err := filepath.Glob("/lib/ld-musl-*"); err == nil && len(matches) > 0 {
+		return true
+	}
+
+	out, err := exec.Command("ldd", "--version").CombinedOutput()
+	if err != nil {
+		// glibc's ldd exits non-zero for --version on some distros; musl's
+		// ldd writes its banner to stderr either way, so inspect output
+		// regardless of the error.
+		return strings.Contains(strings.ToLower(string(out)), "musl")
+	}
+	return strings.Contains(strings.ToLower(string(out)), "musl")
+}
+
+// assetName returns the release asset file name for the running platform.
+// The version is not part of the file name; it is only encoded in the
+// release tag that releaseBaseURL builds the download URL from.
+func assetName() (string, error) {
+	p, err := detectPlatform()
+	if err != nil {
+		return "", err
+	}
+	return assetNameForPlatform(p)
+}
+
+// assetNameForPlatform returns the release asset file name for p. Pulled
+// out of assetName so tests can pin the expected name for every supported
+// platform, not just the one the test binary happens to run on.
+func assetNameForPlatform(p platform) (string, error) {
+	switch {
+	case p.os == "linux" && p.arch == "amd64" && !p.musl:
+		return "libpact_ffi-linux-x86_64.so.gz", nil
+	case p.os == "linux" && p.arch == "amd64" && p.musl:
+		return "libpact_ffi-linux-x86_64-musl.so.gz", nil
+	case p.os == "linux" && p.arch == "arm64" && !p.musl:
+		return "libpact_ffi-linux-aarch64.so.gz", nil
+	case p.os == "linux" && p.arch == "arm64" && p.musl:
+		return "libpact_ffi-linux-aarch64-musl.so.gz", nil
+	case p.os == "darwin" && p.arch == "amd64":
+		return "libpact_ffi-osx-x86_64.dylib.gz", nil
+	case p.os == "darwin" && p.arch == "arm64":
+		return "libpact_ffi-osx-aarch64-apple-darwin.dylib.gz", nil
+	case p.os == "windows" && p.arch == "amd64":
+		return "libpact_ffi-windows-x86_64.dll.gz", nil
+	case p.os == "windows" && p.arch == "arm64":
+		return "libpact_ffi-windows-aarch64.dll.gz", nil
+	default:
+		return "", fmt.Errorf("installer: no known release asset for %s/%s", p.os, p.arch)
+	}
+}
+
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("installer: failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("installer: unexpected status %s fetching %s", resp.Status, url)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("installer: failed to read response body from %s: %w", url, err)
+	}
+	return data, nil
+}
+
+func verifyChecksum(data []byte, checksumURL string) error {
+	expected, err := download(checksumURL)
+	if err != nil {
+		return fmt.Errorf("installer: failed to fetch checksum: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	got := hex.EncodeToString(sum[:])
+	want := strings.TrimSpace(strings.Fields(string(expected))[0])
+
+	if got != want {
+		return fmt.Errorf("installer: checksum mismatch: got %s, want %s", got, want)
+	}
+	return nil
+}
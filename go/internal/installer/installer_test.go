@@ -0,0 +1,79 @@
+package installer
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestCacheDirUsesXDGCacheHome(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/tmp/xdg-cache")
+
+	dir, err := cacheDir("1.2.3")
+	if err != nil {
+		t.Fatalf("cacheDir() returned error: %v", err)
+	}
+
+	want := filepath.Join("/tmp/xdg-cache", "pact-go", "ffi", "1.2.3")
+	if dir != want {
+		t.Errorf("cacheDir() = %q, want %q", dir, want)
+	}
+}
+
+func TestCacheDirFallsBackToHome(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "")
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory available: %v", err)
+	}
+
+	dir, err := cacheDir("1.2.3")
+	if err != nil {
+		t.Fatalf("cacheDir() returned error: %v", err)
+	}
+
+	want := filepath.Join(home, ".cache", "pact-go", "ffi", "1.2.3")
+	if dir != want {
+		t.Errorf("cacheDir() = %q, want %q", dir, want)
+	}
+}
+
+func TestAssetNameForPlatform(t *testing.T) {
+	cases := []struct {
+		platform platform
+		want     string
+	}{
+		{platform{os: "linux", arch: "amd64"}, "libpact_ffi-linux-x86_64.so.gz"},
+		{platform{os: "linux", arch: "amd64", musl: true}, "libpact_ffi-linux-x86_64-musl.so.gz"},
+		{platform{os: "linux", arch: "arm64"}, "libpact_ffi-linux-aarch64.so.gz"},
+		{platform{os: "linux", arch: "arm64", musl: true}, "libpact_ffi-linux-aarch64-musl.so.gz"},
+		{platform{os: "darwin", arch: "amd64"}, "libpact_ffi-osx-x86_64.dylib.gz"},
+		{platform{os: "darwin", arch: "arm64"}, "libpact_ffi-osx-aarch64-apple-darwin.dylib.gz"},
+		{platform{os: "windows", arch: "amd64"}, "libpact_ffi-windows-x86_64.dll.gz"},
+		{platform{os: "windows", arch: "arm64"}, "libpact_ffi-windows-aarch64.dll.gz"},
+	}
+
+	for _, c := range cases {
+		got, err := assetNameForPlatform(c.platform)
+		if err != nil {
+			t.Errorf("assetNameForPlatform(%+v) returned error: %v", c.platform, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("assetNameForPlatform(%+v) = %q, want %q", c.platform, got, c.want)
+		}
+	}
+}
+
+func TestAssetNameMatchesRunningPlatform(t *testing.T) {
+	name, err := assetName()
+	if err != nil {
+		t.Fatalf("assetName() returned error: %v", err)
+	}
+
+	if name == "" {
+		t.Errorf("assetName() returned an empty name for %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+}
@@ -0,0 +1,210 @@
+package native
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Version returns the libpact_ffi version string.
+func Version() string {
+	return pactffiVersion()
+}
+
+// LoggerInit initialises the FFI-side logging subsystem. It must be called
+// before LoggerAttachSink/LoggerApply.
+func LoggerInit() {
+	pactffiLoggerInit()
+}
+
+// LoggerAttachSink registers a log sink, e.g. "stdout", "stderr" or a file
+// path, at the given level (0=off .. 5=trace).
+func LoggerAttachSink(sinkSpecifier string, level uint8) error {
+	if rc := pactffiLoggerAttachSink(sinkSpecifier, level); rc != 0 {
+		return fmt.Errorf("native: pactffi_logger_attach_sink(%q) failed with code %d", sinkSpecifier, rc)
+	}
+	return nil
+}
+
+// LoggerApply commits the sinks registered via LoggerAttachSink.
+func LoggerApply() error {
+	if rc := pactffiLoggerApply(); rc != 0 {
+		return fmt.Errorf("native: pactffi_logger_apply failed with code %d", rc)
+	}
+	return nil
+}
+
+// LogMessage emits a single log line tagged with the given source and
+// level (e.g. "INFO", "DEBUG").
+func LogMessage(source, level, message string) {
+	pactffiLogMessage(source, level, message)
+}
+
+// LogToStdout is a convenience that configures the default stdout logger at
+// the given level in one call.
+func LogToStdout(level int32) error {
+	if rc := pactffiLogToStdout(level); rc != 0 {
+		return fmt.Errorf("native: pactffi_log_to_stdout failed with code %d", rc)
+	}
+	return nil
+}
+
+// FetchLogBuffer returns the contents accumulated by a "buffer" log sink
+// registered for logID (the empty string selects the default buffer).
+func FetchLogBuffer(logID string) string {
+	return pactffiFetchLogBuffer(logID)
+}
+
+// NewVerifier creates a new provider verifier handle.
+func NewVerifier() uintptr {
+	return pactffiVerifierNew()
+}
+
+// VerifierSetProviderInfo configures the provider under verification.
+func VerifierSetProviderInfo(verifier uintptr, name, scheme, host string, port int32, path string) {
+	pactffiVerifierSetProviderInfo(verifier, name, scheme, host, port, path)
+}
+
+// VerifierAddDirectorySource adds a directory of pact files as a source for
+// verification.
+func VerifierAddDirectorySource(verifier uintptr, dir string) {
+	pactffiVerifierAddDirectorySource(verifier, dir)
+}
+
+// VerifierExecute runs the configured verification and returns the FFI
+// result code (0 on success).
+func VerifierExecute(verifier uintptr) int32 {
+	return pactffiVerifierExecute(verifier)
+}
+
+// VerifierShutdown releases the verifier handle. Safe to defer immediately
+// after NewVerifier.
+func VerifierShutdown(verifier uintptr) {
+	pactffiVerifierShutdown(verifier)
+}
+
+// NewMessagePact creates a new message pact between consumer and provider.
+func NewMessagePact(consumer, provider string) PactHandle {
+	return pactffiNewMessagePact(consumer, provider)
+}
+
+// NewMessageInteraction creates a new asynchronous message interaction on
+// the given pact.
+func NewMessageInteraction(pact PactHandle, description string) InteractionHandle {
+	return pactffiNewMessageInteraction(pact, description)
+}
+
+// Given adds a provider state to an interaction.
+func Given(interaction InteractionHandle, state string) {
+	pactffiGiven(interaction, state)
+}
+
+// MessageExpectsToReceive sets the description of what the message
+// interaction expects to receive.
+func MessageExpectsToReceive(interaction InteractionHandle, description string) {
+	pactffiMessageExpectsToReceive(interaction, description)
+}
+
+// WithBody sets the body for the given part of an interaction.
+func WithBody(interaction InteractionHandle, part InteractionPart, contentType string, body []byte) error {
+	if rc := pactffiWithBody(interaction, int32(part), contentType, string(body)); rc == 0 {
+		return fmt.Errorf("native: pactffi_with_body failed for interaction %d", interaction)
+	}
+	return nil
+}
+
+// PactHandleGetMessageIter returns an iterator over the messages configured
+// on a message pact.
+func PactHandleGetMessageIter(pact PactHandle) uintptr {
+	return pactffiPactHandleGetMessageIter(pact)
+}
+
+// PactMessageIterNext advances a message iterator, returning 0 once
+// exhausted.
+func PactMessageIterNext(iter uintptr) uintptr {
+	return pactffiPactMessageIterNext(iter)
+}
+
+// MessageGetContentsLength returns the length in bytes of a message's body.
+func MessageGetContentsLength(message uintptr) int32 {
+	return pactffiMessageGetContentsLength(message)
+}
+
+// MessageGetContentsBin returns a pointer to a message's raw body bytes.
+// The caller is expected to copy out MessageGetContentsLength bytes before
+// the iterator is advanced again.
+func MessageGetContentsBin(message uintptr) uintptr {
+	return pactffiMessageGetContentsBin(message)
+}
+
+// NewSyncMessageInteraction creates a new synchronous (request/response)
+// message interaction on the given pact.
+func NewSyncMessageInteraction(pact PactHandle, description string) InteractionHandle {
+	return pactffiNewSyncMessageInteraction(pact, description)
+}
+
+// PactHandleGetSyncMessageIter returns an iterator over the synchronous
+// messages configured on a message pact.
+func PactHandleGetSyncMessageIter(pact PactHandle) uintptr {
+	return pactffiPactHandleGetSyncMessageIter(pact)
+}
+
+// PactSyncMessageIterNext advances a synchronous message iterator,
+// returning 0 once exhausted.
+func PactSyncMessageIterNext(iter uintptr) uintptr {
+	return pactffiPactSyncMessageIterNext(iter)
+}
+
+// SyncMessageGetRequestContents returns the raw request body bytes of a
+// synchronous message.
+func SyncMessageGetRequestContents(message uintptr) []byte {
+	length := pactffiSyncMessageGetRequestContentsLength(message)
+	if length == 0 {
+		return nil
+	}
+	return ReadBytes(pactffiSyncMessageGetRequestContentsBin(message), length)
+}
+
+// SyncMessageGetResponseContents returns the raw response body bytes of a
+// synchronous message. Pact allows more than one response per request;
+// index selects which one.
+func SyncMessageGetResponseContents(message uintptr, index uint32) []byte {
+	length := pactffiSyncMessageGetResponseContentsLength(message, index)
+	if length == 0 {
+		return nil
+	}
+	return ReadBytes(pactffiSyncMessageGetResponseContentsBin(message, index), length)
+}
+
+// UsingPlugin registers a Pact plugin (protobuf, gRPC, Avro, etc.) by name
+// and version to be used when building interaction contents for pact.
+func UsingPlugin(pact PactHandle, name, version string) error {
+	if rc := pactffiUsingPlugin(pact, name, version); rc != 0 {
+		return fmt.Errorf("native: pactffi_using_plugin(%s/%s) failed with code %d", name, version, rc)
+	}
+	return nil
+}
+
+// CleanupPlugins releases every plugin handle registered against pact via
+// UsingPlugin.
+func CleanupPlugins(pact PactHandle) {
+	pactffiCleanupPlugins(pact)
+}
+
+// InteractionContents sets a structured interaction body, described by a
+// plugin-specific JSON spec, on the given part of an interaction.
+func InteractionContents(interaction InteractionHandle, part InteractionPart, contentType, contents string) error {
+	if rc := pactffiInteractionContents(interaction, int32(part), contentType, contents); rc != 0 {
+		return fmt.Errorf("native: pactffi_interaction_contents failed for interaction %d with code %d", interaction, rc)
+	}
+	return nil
+}
+
+// ReadBytes copies length bytes out of the native heap at ptr into a
+// freshly allocated Go byte slice. It is used to pull message/body
+// contents returned as a raw pointer+length pair out of the FFI boundary.
+func ReadBytes(ptr uintptr, length int32) []byte {
+	if ptr == 0 || length == 0 {
+		return nil
+	}
+	return append([]byte(nil), (*[1 << 30]byte)(unsafe.Pointer(ptr))[:length:length]...)
+}
@@ -0,0 +1,44 @@
+package native
+
+// Raw entry points into libpact_ffi. Each variable mirrors the signature of
+// the pactffi_* C function it is bound to; the platform-specific loader in
+// lib_unix.go / lib_windows.go populates them during LoadLibrary. Typed,
+// idiomatic wrappers over these live in ffi.go.
+var (
+	pactffiVersion           func() string
+	pactffiLoggerInit        func()
+	pactffiLoggerAttachSink  func(sinkSpecifier string, level uint8) int32
+	pactffiLoggerApply       func() int32
+	pactffiLogMessage        func(source, level, message string)
+	pactffiLogToStdout       func(level int32) int32
+	pactffiFetchLogBuffer    func(logID string) string
+
+	pactffiVerifierNew                func() uintptr
+	pactffiVerifierSetProviderInfo     func(handle uintptr, name, scheme, host string, port int32, path string)
+	pactffiVerifierAddDirectorySource  func(handle uintptr, source string)
+	pactffiVerifierShutdown            func(handle uintptr)
+	pactffiVerifierExecute             func(handle uintptr) int32
+
+	pactffiNewMessagePact           func(consumer, provider string) PactHandle
+	pactffiNewMessageInteraction    func(pact PactHandle, description string) InteractionHandle
+	pactffiGiven                    func(interaction InteractionHandle, state string)
+	pactffiMessageExpectsToReceive  func(interaction InteractionHandle, description string)
+	pactffiWithBody                 func(interaction InteractionHandle, part int32, contentType string, body string) int32
+
+	pactffiPactHandleGetMessageIter  func(pact PactHandle) uintptr
+	pactffiPactMessageIterNext       func(iter uintptr) uintptr
+	pactffiMessageGetContentsLength  func(message uintptr) int32
+	pactffiMessageGetContentsBin     func(message uintptr) uintptr
+
+	pactffiNewSyncMessageInteraction       func(pact PactHandle, description string) InteractionHandle
+	pactffiPactHandleGetSyncMessageIter    func(pact PactHandle) uintptr
+	pactffiPactSyncMessageIterNext         func(iter uintptr) uintptr
+	pactffiSyncMessageGetRequestContentsLength   func(message uintptr) int32
+	pactffiSyncMessageGetRequestContentsBin      func(message uintptr) uintptr
+	pactffiSyncMessageGetResponseContentsLength  func(message uintptr, index uint32) int32
+	pactffiSyncMessageGetResponseContentsBin     func(message uintptr, index uint32) uintptr
+
+	pactffiUsingPlugin        func(pact PactHandle, name, version string) int32
+	pactffiCleanupPlugins     func(pact PactHandle)
+	pactffiInteractionContents func(interaction InteractionHandle, part int32, contentType, contents string) int32
+)
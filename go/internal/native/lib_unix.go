@@ -0,0 +1,78 @@
+//go:build linux || darwin
+
+package native
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ebitengine/purego"
+)
+
+var (
+	libHandle uintptr
+	loadOnce  sync.Once
+	loadErr   error
+)
+
+// LoadLibrary resolves libpact_ffi on disk and binds every pactffi_* symbol
+// this package uses. It is safe to call multiple times; the library is only
+// opened and bound once.
+func LoadLibrary() error {
+	loadOnce.Do(func() {
+		path, err := resolveLibraryPath()
+		if err != nil {
+			loadErr = err
+			return
+		}
+
+		libHandle, err = purego.Dlopen(path, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		if err != nil {
+			loadErr = fmt.Errorf("native: failed to dlopen %s: %w", path, err)
+			return
+		}
+
+		bindSymbols(libHandle)
+	})
+
+	return loadErr
+}
+
+func bindSymbols(lib uintptr) {
+	purego.RegisterLibFunc(&pactffiVersion, lib, "pactffi_version")
+	purego.RegisterLibFunc(&pactffiLoggerInit, lib, "pactffi_logger_init")
+	purego.RegisterLibFunc(&pactffiLoggerAttachSink, lib, "pactffi_logger_attach_sink")
+	purego.RegisterLibFunc(&pactffiLoggerApply, lib, "pactffi_logger_apply")
+	purego.RegisterLibFunc(&pactffiLogMessage, lib, "pactffi_log_message")
+	purego.RegisterLibFunc(&pactffiLogToStdout, lib, "pactffi_log_to_stdout")
+	purego.RegisterLibFunc(&pactffiFetchLogBuffer, lib, "pactffi_fetch_log_buffer")
+
+	purego.RegisterLibFunc(&pactffiVerifierNew, lib, "pactffi_verifier_new")
+	purego.RegisterLibFunc(&pactffiVerifierSetProviderInfo, lib, "pactffi_verifier_set_provider_info")
+	purego.RegisterLibFunc(&pactffiVerifierAddDirectorySource, lib, "pactffi_verifier_add_directory_source")
+	purego.RegisterLibFunc(&pactffiVerifierShutdown, lib, "pactffi_verifier_shutdown")
+	purego.RegisterLibFunc(&pactffiVerifierExecute, lib, "pactffi_verifier_execute")
+
+	purego.RegisterLibFunc(&pactffiNewMessagePact, lib, "pactffi_new_message_pact")
+	purego.RegisterLibFunc(&pactffiNewMessageInteraction, lib, "pactffi_new_message_interaction")
+	purego.RegisterLibFunc(&pactffiGiven, lib, "pactffi_given")
+	purego.RegisterLibFunc(&pactffiMessageExpectsToReceive, lib, "pactffi_message_expects_to_receive")
+	purego.RegisterLibFunc(&pactffiWithBody, lib, "pactffi_with_body")
+
+	purego.RegisterLibFunc(&pactffiPactHandleGetMessageIter, lib, "pactffi_pact_handle_get_message_iter")
+	purego.RegisterLibFunc(&pactffiPactMessageIterNext, lib, "pactffi_pact_message_iter_next")
+	purego.RegisterLibFunc(&pactffiMessageGetContentsLength, lib, "pactffi_message_get_contents_length")
+	purego.RegisterLibFunc(&pactffiMessageGetContentsBin, lib, "pactffi_message_get_contents_bin")
+
+	purego.RegisterLibFunc(&pactffiNewSyncMessageInteraction, lib, "pactffi_new_sync_message_interaction")
+	purego.RegisterLibFunc(&pactffiPactHandleGetSyncMessageIter, lib, "pactffi_pact_handle_get_sync_message_iter")
+	purego.RegisterLibFunc(&pactffiPactSyncMessageIterNext, lib, "pactffi_pact_sync_message_iter_next")
+	purego.RegisterLibFunc(&pactffiSyncMessageGetRequestContentsLength, lib, "pactffi_sync_message_get_request_contents_length")
+	purego.RegisterLibFunc(&pactffiSyncMessageGetRequestContentsBin, lib, "pactffi_sync_message_get_request_contents_bin")
+	purego.RegisterLibFunc(&pactffiSyncMessageGetResponseContentsLength, lib, "pactffi_sync_message_get_response_contents_length")
+	purego.RegisterLibFunc(&pactffiSyncMessageGetResponseContentsBin, lib, "pactffi_sync_message_get_response_contents_bin")
+
+	purego.RegisterLibFunc(&pactffiUsingPlugin, lib, "pactffi_using_plugin")
+	purego.RegisterLibFunc(&pactffiCleanupPlugins, lib, "pactffi_cleanup_plugins")
+	purego.RegisterLibFunc(&pactffiInteractionContents, lib, "pactffi_interaction_contents")
+}
@@ -0,0 +1,280 @@
+//go:build windows
+
+package native
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	libHandle syscall.Handle
+	loadOnce  sync.Once
+	loadErr   error
+)
+
+// LoadLibrary resolves libpact_ffi on disk and binds every pactffi_* symbol
+// this package uses. It is safe to call multiple times; the library is only
+// opened and bound once.
+func LoadLibrary() error {
+	loadOnce.Do(func() {
+		path, err := resolveLibraryPath()
+		if err != nil {
+			loadErr = err
+			return
+		}
+
+		handle, err := syscall.LoadLibrary(path)
+		if err != nil {
+			loadErr = fmt.Errorf("native: failed to LoadLibrary %s: %w", path, err)
+			return
+		}
+		libHandle = handle
+
+		if err := bindSymbols(libHandle); err != nil {
+			loadErr = err
+		}
+	})
+
+	return loadErr
+}
+
+// proc resolves a single exported symbol via GetProcAddress.
+func proc(lib syscall.Handle, name string) (uintptr, error) {
+	addr, err := syscall.GetProcAddress(lib, name)
+	if err != nil {
+		return 0, fmt.Errorf("native: symbol %s not found: %w", name, err)
+	}
+	return addr, nil
+}
+
+func bindSymbols(lib syscall.Handle) error {
+	type binding struct {
+		name string
+		fn   interface{}
+	}
+
+	bindings := []binding{
+		{"pactffi_version", &pactffiVersion},
+		{"pactffi_logger_init", &pactffiLoggerInit},
+		{"pactffi_logger_attach_sink", &pactffiLoggerAttachSink},
+		{"pactffi_logger_apply", &pactffiLoggerApply},
+		{"pactffi_log_message", &pactffiLogMessage},
+		{"pactffi_log_to_stdout", &pactffiLogToStdout},
+		{"pactffi_fetch_log_buffer", &pactffiFetchLogBuffer},
+		{"pactffi_verifier_new", &pactffiVerifierNew},
+		{"pactffi_verifier_set_provider_info", &pactffiVerifierSetProviderInfo},
+		{"pactffi_verifier_add_directory_source", &pactffiVerifierAddDirectorySource},
+		{"pactffi_verifier_shutdown", &pactffiVerifierShutdown},
+		{"pactffi_verifier_execute", &pactffiVerifierExecute},
+		{"pactffi_new_message_pact", &pactffiNewMessagePact},
+		{"pactffi_new_message_interaction", &pactffiNewMessageInteraction},
+		{"pactffi_given", &pactffiGiven},
+		{"pactffi_message_expects_to_receive", &pactffiMessageExpectsToReceive},
+		{"pactffi_with_body", &pactffiWithBody},
+		{"pactffi_pact_handle_get_message_iter", &pactffiPactHandleGetMessageIter},
+		{"pactffi_pact_message_iter_next", &pactffiPactMessageIterNext},
+		{"pactffi_message_get_contents_length", &pactffiMessageGetContentsLength},
+		{"pactffi_message_get_contents_bin", &pactffiMessageGetContentsBin},
+		{"pactffi_new_sync_message_interaction", &pactffiNewSyncMessageInteraction},
+		{"pactffi_pact_handle_get_sync_message_iter", &pactffiPactHandleGetSyncMessageIter},
+		{"pactffi_pact_sync_message_iter_next", &pactffiPactSyncMessageIterNext},
+		{"pactffi_sync_message_get_request_contents_length", &pactffiSyncMessageGetRequestContentsLength},
+		{"pactffi_sync_message_get_request_contents_bin", &pactffiSyncMessageGetRequestContentsBin},
+		{"pactffi_sync_message_get_response_contents_length", &pactffiSyncMessageGetResponseContentsLength},
+		{"pactffi_sync_message_get_response_contents_bin", &pactffiSyncMessageGetResponseContentsBin},
+		{"pactffi_using_plugin", &pactffiUsingPlugin},
+		{"pactffi_cleanup_plugins", &pactffiCleanupPlugins},
+		{"pactffi_interaction_contents", &pactffiInteractionContents},
+	}
+
+	for _, b := range bindings {
+		addr, err := proc(lib, b.name)
+		if err != nil {
+			return err
+		}
+		bindProc(addr, b.fn)
+	}
+
+	return nil
+}
+
+// bindProc wires a resolved proc address into a typed Go function variable,
+// marshalling arguments/return values across the stdcall boundary used by
+// the Windows build of libpact_ffi.
+//
+// Every string argument is converted to a *byte with cBytes and passed to
+// syscall.Syscall[6] as uintptr(unsafe.Pointer(b)) computed directly in the
+// argument list, and each such *byte is kept reachable with
+// runtime.KeepAlive after the call returns. syscall.Syscall does not itself
+// know that the uintptr it received started life as a pointer, so without
+// KeepAlive the backing buffer is not guaranteed to survive long enough for
+// the callee to read it once the pointer has been reduced to a plain
+// uintptr.
+func bindProc(addr uintptr, fn interface{}) {
+	switch f := fn.(type) {
+	case *func() string:
+		*f = func() string {
+			r, _, _ := syscall.Syscall(addr, 0, 0, 0, 0)
+			return goString(r)
+		}
+	case *func():
+		*f = func() {
+			syscall.Syscall(addr, 0, 0, 0, 0)
+		}
+	case *func(string) string:
+		*f = func(a string) string {
+			ba := cBytes(a)
+			r, _, _ := syscall.Syscall(addr, 1, uintptr(unsafe.Pointer(ba)), 0, 0)
+			runtime.KeepAlive(ba)
+			return goString(r)
+		}
+	case *func(string, uint8) int32:
+		*f = func(a string, b uint8) int32 {
+			ba := cBytes(a)
+			r, _, _ := syscall.Syscall(addr, 2, uintptr(unsafe.Pointer(ba)), uintptr(b), 0)
+			runtime.KeepAlive(ba)
+			return int32(r)
+		}
+	case *func() int32:
+		*f = func() int32 {
+			r, _, _ := syscall.Syscall(addr, 0, 0, 0, 0)
+			return int32(r)
+		}
+	case *func(string, string, string):
+		*f = func(a, b, c string) {
+			ba, bb, bc := cBytes(a), cBytes(b), cBytes(c)
+			syscall.Syscall(addr, 3, uintptr(unsafe.Pointer(ba)), uintptr(unsafe.Pointer(bb)), uintptr(unsafe.Pointer(bc)))
+			runtime.KeepAlive(ba)
+			runtime.KeepAlive(bb)
+			runtime.KeepAlive(bc)
+		}
+	case *func(int32) int32:
+		*f = func(a int32) int32 {
+			r, _, _ := syscall.Syscall(addr, 1, uintptr(a), 0, 0)
+			return int32(r)
+		}
+	case *func() uintptr:
+		*f = func() uintptr {
+			r, _, _ := syscall.Syscall(addr, 0, 0, 0, 0)
+			return r
+		}
+	case *func(uintptr, string, string, string, int32, string):
+		*f = func(h uintptr, name, scheme, host string, port int32, path string) {
+			bName, bScheme, bHost, bPath := cBytes(name), cBytes(scheme), cBytes(host), cBytes(path)
+			syscall.Syscall6(addr, 6, h, uintptr(unsafe.Pointer(bName)), uintptr(unsafe.Pointer(bScheme)), uintptr(unsafe.Pointer(bHost)), uintptr(port), uintptr(unsafe.Pointer(bPath)))
+			runtime.KeepAlive(bName)
+			runtime.KeepAlive(bScheme)
+			runtime.KeepAlive(bHost)
+			runtime.KeepAlive(bPath)
+		}
+	case *func(uintptr, string):
+		*f = func(h uintptr, s string) {
+			bs := cBytes(s)
+			syscall.Syscall(addr, 2, h, uintptr(unsafe.Pointer(bs)), 0)
+			runtime.KeepAlive(bs)
+		}
+	case *func(uintptr):
+		*f = func(h uintptr) {
+			syscall.Syscall(addr, 1, h, 0, 0)
+		}
+	case *func(uintptr) int32:
+		*f = func(h uintptr) int32 {
+			r, _, _ := syscall.Syscall(addr, 1, h, 0, 0)
+			return int32(r)
+		}
+	case *func(consumer, provider string) PactHandle:
+		*f = func(consumer, provider string) PactHandle {
+			bConsumer, bProvider := cBytes(consumer), cBytes(provider)
+			r, _, _ := syscall.Syscall(addr, 2, uintptr(unsafe.Pointer(bConsumer)), uintptr(unsafe.Pointer(bProvider)), 0)
+			runtime.KeepAlive(bConsumer)
+			runtime.KeepAlive(bProvider)
+			return PactHandle(r)
+		}
+	case *func(pact PactHandle, description string) InteractionHandle:
+		*f = func(pact PactHandle, description string) InteractionHandle {
+			bDescription := cBytes(description)
+			r, _, _ := syscall.Syscall(addr, 2, uintptr(pact), uintptr(unsafe.Pointer(bDescription)), 0)
+			runtime.KeepAlive(bDescription)
+			return InteractionHandle(r)
+		}
+	case *func(interaction InteractionHandle, state string):
+		*f = func(interaction InteractionHandle, state string) {
+			bState := cBytes(state)
+			syscall.Syscall(addr, 2, uintptr(interaction), uintptr(unsafe.Pointer(bState)), 0)
+			runtime.KeepAlive(bState)
+		}
+	case *func(interaction InteractionHandle, part int32, contentType string, body string) int32:
+		*f = func(interaction InteractionHandle, part int32, contentType string, body string) int32 {
+			bContentType, bBody := cBytes(contentType), cBytes(body)
+			r, _, _ := syscall.Syscall6(addr, 4, uintptr(interaction), uintptr(part), uintptr(unsafe.Pointer(bContentType)), uintptr(unsafe.Pointer(bBody)), 0, 0)
+			runtime.KeepAlive(bContentType)
+			runtime.KeepAlive(bBody)
+			return int32(r)
+		}
+	case *func(pact PactHandle) uintptr:
+		*f = func(pact PactHandle) uintptr {
+			r, _, _ := syscall.Syscall(addr, 1, uintptr(pact), 0, 0)
+			return r
+		}
+	case *func(iter uintptr) uintptr:
+		*f = func(iter uintptr) uintptr {
+			r, _, _ := syscall.Syscall(addr, 1, iter, 0, 0)
+			return r
+		}
+	case *func(message uintptr, index uint32) int32:
+		*f = func(message uintptr, index uint32) int32 {
+			r, _, _ := syscall.Syscall(addr, 2, message, uintptr(index), 0)
+			return int32(r)
+		}
+	case *func(message uintptr, index uint32) uintptr:
+		*f = func(message uintptr, index uint32) uintptr {
+			r, _, _ := syscall.Syscall(addr, 2, message, uintptr(index), 0)
+			return r
+		}
+	case *func(pact PactHandle, name, version string) int32:
+		*f = func(pact PactHandle, name, version string) int32 {
+			bName, bVersion := cBytes(name), cBytes(version)
+			r, _, _ := syscall.Syscall(addr, 3, uintptr(pact), uintptr(unsafe.Pointer(bName)), uintptr(unsafe.Pointer(bVersion)))
+			runtime.KeepAlive(bName)
+			runtime.KeepAlive(bVersion)
+			return int32(r)
+		}
+	case *func(pact PactHandle):
+		*f = func(pact PactHandle) {
+			syscall.Syscall(addr, 1, uintptr(pact), 0, 0)
+		}
+	default:
+		panic(fmt.Sprintf("native: no stdcall shim registered for %T", fn))
+	}
+}
+
+// cBytes returns a NUL-terminated copy of s suitable for passing to a C
+// function. Callers must runtime.KeepAlive the result until the call that
+// consumes it has returned.
+func cBytes(s string) *byte {
+	b, err := syscall.BytePtrFromString(s)
+	if err != nil {
+		// s contained a NUL byte; fall back to an empty C string rather
+		// than dereferencing garbage on the native side.
+		b, _ = syscall.BytePtrFromString("")
+	}
+	return b
+}
+
+// goString converts a NUL-terminated C string returned by libpact_ffi into a
+// Go string. The FFI surface always returns UTF-8, not UTF-16.
+func goString(ptr uintptr) string {
+	if ptr == 0 {
+		return ""
+	}
+	bytes := (*[1 << 20]byte)(unsafe.Pointer(ptr))
+	n := 0
+	for bytes[n] != 0 {
+		n++
+	}
+	return string(bytes[:n])
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/internal/native"
+)
+
+func TestLoggerBufferSink(t *testing.T) {
+	if err := native.LoadLibrary(); err != nil {
+		t.Skipf("libpact_ffi not available: %v", err)
+	}
+
+	logger := &Logger{}
+	logger.AttachSink(BUFFER, "", DEBUG)
+	if err := logger.Apply(); err != nil {
+		t.Fatalf("Apply() returned error: %v", err)
+	}
+
+	logger.LogMessage("log_test", INFO, "hello from the buffer sink")
+
+	contents := logger.FetchBuffer("")
+	if !strings.Contains(contents, "hello from the buffer sink") {
+		t.Fatalf("expected buffer to contain logged message, got: %q", contents)
+	}
+}
+
+func TestSinkSpecifier(t *testing.T) {
+	cases := []struct {
+		kind    SinkKind
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{STDOUT, "", "stdout", false},
+		{STDERR, "", "stderr", false},
+		{BUFFER, "", "buffer", false},
+		{FILE, "/tmp/pact.log", "file /tmp/pact.log", false},
+		{FILE, "", "", true},
+	}
+
+	for _, c := range cases {
+		got, err := sinkSpecifier(c.kind, c.target)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("sinkSpecifier(%v, %q) expected an error, got none", c.kind, c.target)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("sinkSpecifier(%v, %q) returned unexpected error: %v", c.kind, c.target, err)
+		}
+		if got != c.want {
+			t.Errorf("sinkSpecifier(%v, %q) = %q, want %q", c.kind, c.target, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Package native contains the Go bindings into the Pact Reference (pact_ffi)
+// library. Unlike earlier versions of this package, symbols are not linked
+// at compile time via cgo; instead LoadLibrary locates the platform shared
+// library on disk and binds every pactffi_* entry point at runtime, so
+// consumers of this package can build with CGO_ENABLED=0.
+package native
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/pact-foundation/pact-go/v2/internal/installer"
+)
+
+// defaultFFIVersion is installed automatically when libpact_ffi cannot be
+// found anywhere else. It can be overridden with PACT_FFI_VERSION, e.g. to
+// pin to the version a project's other Pact tooling already uses.
+const defaultFFIVersion = "0.4.22"
+
+func ffiVersion() string {
+	if v := os.Getenv("PACT_FFI_VERSION"); v != "" {
+		return v
+	}
+	return defaultFFIVersion
+}
+
+// libraryFileName returns the platform-specific shared library file name
+// for libpact_ffi.
+func libraryFileName() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "libpact_ffi.dylib"
+	case "windows":
+		return "pact_ffi.dll"
+	default:
+		return "libpact_ffi.so"
+	}
+}
+
+// defaultSearchDirs returns the platform conventions consulted when
+// PACT_LD_LIBRARY_PATH is not set.
+func defaultSearchDirs() []string {
+	switch runtime.GOOS {
+	case "windows":
+		dirs := []string{`C:\Pact\bin`}
+		if local := os.Getenv("LOCALAPPDATA"); local != "" {
+			dirs = append([]string{filepath.Join(local, "Pact")}, dirs...)
+		}
+		return dirs
+	case "darwin":
+		return []string{"/usr/local/lib", "/opt/homebrew/lib"}
+	default:
+		return []string{"/usr/local/lib", "/usr/lib", "/usr/lib64"}
+	}
+}
+
+// resolveLibraryPath locates libpact_ffi on disk. PACT_LD_LIBRARY_PATH, when
+// set, is treated as an explicit override: the caller presumably pointed it
+// at a custom or patched build, so it is an error if the library isn't
+// there, rather than silently falling through to some other libpact_ffi.
+// Only when the env var is unset do we consult a short list of
+// platform-specific default directories and, failing that, download and
+// cache the correct release artifact for the running platform via
+// installer.Ensure. This last step is what lets a program just run on, say,
+// Apple Silicon or an Alpine container without the caller having to
+// hand-install the matching libpact_ffi themselves.
+func resolveLibraryPath() (string, error) {
+	name := libraryFileName()
+
+	if dir := os.Getenv("PACT_LD_LIBRARY_PATH"); dir != "" {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); err != nil {
+			return "", fmt.Errorf("native: %s not found in PACT_LD_LIBRARY_PATH %q: %w", name, dir, err)
+		}
+		return candidate, nil
+	}
+
+	for _, dir := range defaultSearchDirs() {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	libPath, err := installer.Ensure(ffiVersion())
+	if err != nil {
+		return "", fmt.Errorf("native: unable to locate %s and failed to install it automatically: %w", name, err)
+	}
+	return libPath, nil
+}
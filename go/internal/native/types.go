@@ -0,0 +1,18 @@
+package native
+
+// PactHandle is an opaque reference to a Pact held by the native library.
+type PactHandle uint16
+
+// InteractionHandle is an opaque reference to a single interaction (message
+// or HTTP) held by the native library.
+type InteractionHandle uint16
+
+// InteractionPart identifies which part of a synchronous interaction a body
+// or header applies to.
+type InteractionPart int
+
+// Which part of a synchronous interaction a body or header applies to.
+const (
+	INTERACTION_PART_REQUEST InteractionPart = iota
+	INTERACTION_PART_RESPONSE
+)
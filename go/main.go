@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	stdlog "log"
+	"os"
+	"path/filepath"
+
+	"github.com/pact-foundation/pact-go/v2/internal/installer"
+	"github.com/pact-foundation/pact-go/v2/internal/native"
+	pactlog "github.com/pact-foundation/pact-go/v2/internal/native/log"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "install" {
+		runInstall(os.Args[2:])
+		return
+	}
+
+	if err := native.LoadLibrary(); err != nil {
+		stdlog.Fatalf("failed to load libpact_ffi: %v", err)
+	}
+
+	logger := &pactlog.Logger{}
+	logger.AttachSink(pactlog.STDOUT, "", pactlog.INFO)
+	if err := logger.Apply(); err != nil {
+		stdlog.Fatal(err)
+	}
+
+	logger.LogMessage("pact-go-ffi", pactlog.INFO, fmt.Sprintf("hello from ffi version: %s", native.Version()))
+}
+
+// runInstall implements `pact-go install`, downloading the libpact_ffi
+// release asset for the running platform and printing the
+// PACT_LD_LIBRARY_PATH export line that points the runtime loader at it.
+// It does not set the variable itself.
+func runInstall(args []string) {
+	fs := flag.NewFlagSet("install", flag.ExitOnError)
+	version := fs.String("version", "", "libpact_ffi version to install (required)")
+	fs.Parse(args)
+
+	if *version == "" {
+		stdlog.Fatal("install: --version is required")
+	}
+
+	libPath, err := installer.Ensure(*version)
+	if err != nil {
+		stdlog.Fatalf("install: %v", err)
+	}
+
+	fmt.Printf("libpact_ffi %s installed at %s\n", *version, libPath)
+	fmt.Printf("export PACT_LD_LIBRARY_PATH=%s\n", filepath.Dir(libPath))
+}
@@ -0,0 +1,248 @@
+// Package message implements the message-pact side (both asynchronous and
+// synchronous request/response messages) of the Pact consumer DSL on top of
+// the native FFI bindings.
+package message
+
+import (
+	"fmt"
+
+	"github.com/pact-foundation/pact-go/v2/internal/native"
+)
+
+// MessagePact is a handle to a single consumer/provider message pact.
+type MessagePact struct {
+	handle native.PactHandle
+}
+
+// interaction is either a *Message or a *SyncMessage; a MessageServer tracks
+// both kinds in a single ordered slice and recovers the concrete type with a
+// type assertion where it matters (see MessageServer.countOf).
+type interaction = any
+
+// Message is a single asynchronous message interaction on a MessagePact.
+type Message struct {
+	handle native.InteractionHandle
+	pact   *MessagePact
+	index  int
+	server *MessageServer
+}
+
+// Index returns this message's position among the MessageServer's
+// asynchronous messages only, i.e. the value to pass to
+// MessageServer.GetMessageContents to fetch its body. It is distinct from
+// SyncMessage.Index, which is numbered against the synchronous messages.
+func (m *Message) Index() int { return m.index }
+
+// SyncMessage is a single synchronous (request/response) message
+// interaction on a MessagePact.
+type SyncMessage struct {
+	handle native.InteractionHandle
+	pact   *MessagePact
+	index  int
+	server *MessageServer
+}
+
+// Index returns this message's position among the MessageServer's
+// synchronous messages only, i.e. the value to pass to
+// MessageServer.GetSyncMessageContents to fetch its contents. It is
+// distinct from Message.Index, which is numbered against the asynchronous
+// messages.
+func (m *SyncMessage) Index() int { return m.index }
+
+// MessageServer is the public interface for managing the message based
+// interface.
+type MessageServer struct {
+	messagePact  *MessagePact
+	interactions []interaction
+	pluginsInUse bool
+}
+
+// NewMessageServer creates a MessageServer for the given consumer/provider
+// pair, loading the native library if it has not already been loaded.
+func NewMessageServer(consumer, provider string) (*MessageServer, error) {
+	if err := native.LoadLibrary(); err != nil {
+		return nil, err
+	}
+
+	pact := &MessagePact{handle: native.NewMessagePact(consumer, provider)}
+	return &MessageServer{messagePact: pact}, nil
+}
+
+// NewMessage registers a new asynchronous message interaction with the
+// given description.
+func (m *MessageServer) NewMessage(description string) *Message {
+	message := &Message{
+		handle: native.NewMessageInteraction(m.messagePact.handle, description),
+		pact:   m.messagePact,
+		index:  m.countOf(func(i interaction) bool { _, ok := i.(*Message); return ok }),
+		server: m,
+	}
+	m.interactions = append(m.interactions, message)
+	return message
+}
+
+// NewSyncMessage registers a new synchronous request/response message
+// interaction with the given description.
+func (m *MessageServer) NewSyncMessage(description string) *SyncMessage {
+	message := &SyncMessage{
+		handle: native.NewSyncMessageInteraction(m.messagePact.handle, description),
+		pact:   m.messagePact,
+		index:  m.countOf(func(i interaction) bool { _, ok := i.(*SyncMessage); return ok }),
+		server: m,
+	}
+	m.interactions = append(m.interactions, message)
+	return message
+}
+
+// UsePlugin registers a Pact plugin (protobuf, gRPC, Avro, etc.) to be used
+// when building interaction contents for this pact. Call Cleanup (ideally
+// deferred immediately after a successful call) to release it.
+func (m *MessageServer) UsePlugin(name, version string) error {
+	if err := native.UsingPlugin(m.messagePact.handle, name, version); err != nil {
+		return err
+	}
+	m.pluginsInUse = true
+	return nil
+}
+
+// Cleanup releases any plugin handles registered via UsePlugin. It is a
+// no-op if no plugin was ever registered, so it is safe to defer
+// unconditionally.
+func (m *MessageServer) Cleanup() {
+	if !m.pluginsInUse {
+		return
+	}
+	native.CleanupPlugins(m.messagePact.handle)
+}
+
+// Given adds a provider state that must hold for this message to be
+// produced.
+func (m *Message) Given(state string) *Message {
+	native.Given(m.handle, state)
+	return m
+}
+
+// ExpectsToReceive documents what the message interaction expects the
+// consumer to receive.
+func (m *Message) ExpectsToReceive(description string) *Message {
+	native.MessageExpectsToReceive(m.handle, description)
+	return m
+}
+
+// WithBody sets the message body and its content type.
+func (m *Message) WithBody(contentType string, body []byte) (*Message, error) {
+	if err := native.WithBody(m.handle, native.INTERACTION_PART_REQUEST, contentType, body); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Given adds a provider state that must hold for this message to be
+// produced.
+func (m *SyncMessage) Given(state string) *SyncMessage {
+	native.Given(m.handle, state)
+	return m
+}
+
+// ExpectsToReceive documents what the message interaction expects the
+// consumer to receive.
+func (m *SyncMessage) ExpectsToReceive(description string) *SyncMessage {
+	native.MessageExpectsToReceive(m.handle, description)
+	return m
+}
+
+// WithRequest sets the request body and its content type.
+func (m *SyncMessage) WithRequest(contentType string, body []byte) (*SyncMessage, error) {
+	if err := native.WithBody(m.handle, native.INTERACTION_PART_REQUEST, contentType, body); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WithResponse sets the response body and its content type.
+func (m *SyncMessage) WithResponse(contentType string, body []byte) (*SyncMessage, error) {
+	if err := native.WithBody(m.handle, native.INTERACTION_PART_RESPONSE, contentType, body); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WithPluginContents sets a structured interaction body, described by a
+// plugin-specific JSON spec, on the given part of the message.
+func (m *Message) WithPluginContents(part native.InteractionPart, contentType, contents string) error {
+	return native.InteractionContents(m.handle, part, contentType, contents)
+}
+
+// WithPluginContents sets a structured interaction body, described by a
+// plugin-specific JSON spec, on the given part of the message.
+func (m *SyncMessage) WithPluginContents(part native.InteractionPart, contentType, contents string) error {
+	return native.InteractionContents(m.handle, part, contentType, contents)
+}
+
+// GetMessageContents returns the raw body bytes of the asynchronous message
+// at index.
+func (m *MessageServer) GetMessageContents(index int) ([]byte, error) {
+	iter := native.PactHandleGetMessageIter(m.messagePact.handle)
+	if iter == 0 {
+		return nil, fmt.Errorf("message: unable to get a message iterator")
+	}
+
+	count := m.countOf(func(i interaction) bool { _, ok := i.(*Message); return ok })
+	for i := 0; i < count; i++ {
+		msg := native.PactMessageIterNext(iter)
+		if i != index {
+			continue
+		}
+		if msg == 0 {
+			return nil, fmt.Errorf("message: retrieved a null message pointer at index %d", index)
+		}
+
+		length := native.MessageGetContentsLength(msg)
+		if length == 0 {
+			return nil, nil // messages may have empty bodies
+		}
+
+		data := native.MessageGetContentsBin(msg)
+		if data == 0 {
+			return nil, nil
+		}
+		return native.ReadBytes(data, length), nil
+	}
+
+	return nil, fmt.Errorf("message: no message registered at index %d", index)
+}
+
+// GetSyncMessageContents returns the raw request and response body bytes of
+// the synchronous message at index, mirroring GetMessageContents for the
+// async path.
+func (m *MessageServer) GetSyncMessageContents(index int) (req, resp []byte, err error) {
+	iter := native.PactHandleGetSyncMessageIter(m.messagePact.handle)
+	if iter == 0 {
+		return nil, nil, fmt.Errorf("message: unable to get a sync message iterator")
+	}
+
+	count := m.countOf(func(i interaction) bool { _, ok := i.(*SyncMessage); return ok })
+	for i := 0; i < count; i++ {
+		msg := native.PactSyncMessageIterNext(iter)
+		if i != index {
+			continue
+		}
+		if msg == 0 {
+			return nil, nil, fmt.Errorf("message: retrieved a null sync message pointer at index %d", index)
+		}
+
+		return native.SyncMessageGetRequestContents(msg), native.SyncMessageGetResponseContents(msg, 0), nil
+	}
+
+	return nil, nil, fmt.Errorf("message: no sync message registered at index %d", index)
+}
+
+func (m *MessageServer) countOf(match func(interaction) bool) int {
+	n := 0
+	for _, i := range m.interactions {
+		if match(i) {
+			n++
+		}
+	}
+	return n
+}
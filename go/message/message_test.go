@@ -0,0 +1,98 @@
+package message
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pact-foundation/pact-go/v2/internal/native"
+)
+
+func newTestServer(t *testing.T) *MessageServer {
+	t.Helper()
+	server, err := NewMessageServer("test-consumer", "test-provider")
+	if err != nil {
+		t.Skipf("libpact_ffi not available: %v", err)
+	}
+	return server
+}
+
+// TestInterleavedMessageIndices exercises a MessageServer where async and
+// sync messages are interleaved. Message.Index/SyncMessage.Index must be
+// numbered per-kind so they can be used directly with
+// GetMessageContents/GetSyncMessageContents, regardless of position in the
+// unified interaction registration order.
+func TestInterleavedMessageIndices(t *testing.T) {
+	server := newTestServer(t)
+
+	first, err := server.NewMessage("first async message").WithBody("text/plain", []byte("async one"))
+	if err != nil {
+		t.Fatalf("WithBody() for first async message returned error: %v", err)
+	}
+
+	sync, err := server.NewSyncMessage("a sync message").WithRequest("text/plain", []byte("sync request"))
+	if err != nil {
+		t.Fatalf("WithRequest() returned error: %v", err)
+	}
+	if _, err := sync.WithResponse("text/plain", []byte("sync response")); err != nil {
+		t.Fatalf("WithResponse() returned error: %v", err)
+	}
+
+	second, err := server.NewMessage("second async message").WithBody("text/plain", []byte("async two"))
+	if err != nil {
+		t.Fatalf("WithBody() for second async message returned error: %v", err)
+	}
+
+	if first.Index() != 0 {
+		t.Fatalf("first.Index() = %d, want 0", first.Index())
+	}
+	if second.Index() != 1 {
+		t.Fatalf("second.Index() = %d, want 1", second.Index())
+	}
+	if sync.Index() != 0 {
+		t.Fatalf("sync.Index() = %d, want 0", sync.Index())
+	}
+
+	firstContents, err := server.GetMessageContents(first.Index())
+	if err != nil {
+		t.Fatalf("GetMessageContents(%d) returned error: %v", first.Index(), err)
+	}
+	if !bytes.Equal(firstContents, []byte("async one")) {
+		t.Fatalf("GetMessageContents(%d) = %q, want %q", first.Index(), firstContents, "async one")
+	}
+
+	secondContents, err := server.GetMessageContents(second.Index())
+	if err != nil {
+		t.Fatalf("GetMessageContents(%d) returned error: %v", second.Index(), err)
+	}
+	if !bytes.Equal(secondContents, []byte("async two")) {
+		t.Fatalf("GetMessageContents(%d) = %q, want %q", second.Index(), secondContents, "async two")
+	}
+
+	req, resp, err := server.GetSyncMessageContents(sync.Index())
+	if err != nil {
+		t.Fatalf("GetSyncMessageContents(%d) returned error: %v", sync.Index(), err)
+	}
+	if !bytes.Equal(req, []byte("sync request")) {
+		t.Fatalf("GetSyncMessageContents(%d) request = %q, want %q", sync.Index(), req, "sync request")
+	}
+	if !bytes.Equal(resp, []byte("sync response")) {
+		t.Fatalf("GetSyncMessageContents(%d) response = %q, want %q", sync.Index(), resp, "sync response")
+	}
+}
+
+// TestUsePluginAndCleanup exercises the plugin lifecycle end to end: a
+// plugin is registered, used to set structured interaction contents, and
+// released via Cleanup.
+func TestUsePluginAndCleanup(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Cleanup()
+
+	if err := server.UsePlugin("protobuf", "0.3.0"); err != nil {
+		t.Skipf("protobuf plugin not available: %v", err)
+	}
+
+	msg := server.NewSyncMessage("a plugin-backed message")
+	if err := msg.WithPluginContents(native.INTERACTION_PART_REQUEST, "application/protobuf", `{"pact:proto":"test.proto"}`); err != nil {
+		t.Fatalf("WithPluginContents() returned error: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+// Package provider implements the provider-side verifier on top of the
+// native FFI bindings.
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pact-foundation/pact-go/v2/internal/native"
+)
+
+// VerifyProvider runs the provider verifier against the pact files found in
+// PACT_PROVIDER_DIR for a provider running on localhost:8000. It returns
+// the FFI result code (0 on success).
+func VerifyProvider() (int, error) {
+	if err := native.LoadLibrary(); err != nil {
+		return 0, err
+	}
+
+	verifier := native.NewVerifier()
+	defer native.VerifierShutdown(verifier)
+
+	native.VerifierSetProviderInfo(verifier, "p1", "http", "localhost", 8000, "/")
+	native.VerifierAddDirectorySource(verifier, os.Getenv("PACT_PROVIDER_DIR"))
+
+	result := native.VerifierExecute(verifier)
+	if result != 0 {
+		return int(result), fmt.Errorf("provider: verification failed with code %d", result)
+	}
+	return 0, nil
+}